@@ -0,0 +1,216 @@
+// Package diff turns the LCS alignment produced by package lcs into an edit
+// script and, from there, into unified diff hunks.
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WKBae/golcs"
+)
+
+// OpKind describes the kind of change an Edit represents.
+type OpKind int
+
+const (
+	// OpEqual marks a range present, unchanged, in both Left and Right.
+	OpEqual OpKind = iota
+	// OpDelete marks a range present only in Left.
+	OpDelete
+	// OpInsert marks a range present only in Right.
+	OpInsert
+	// OpReplace marks a range where Left was replaced by Right.
+	OpReplace
+)
+
+// String implements fmt.Stringer.
+func (op OpKind) String() string {
+	switch op {
+	case OpEqual:
+		return "equal"
+	case OpDelete:
+		return "delete"
+	case OpInsert:
+		return "insert"
+	case OpReplace:
+		return "replace"
+	default:
+		return "unknown"
+	}
+}
+
+// Edit is a single contiguous range of change between the Left and Right
+// sequences used to build an Lcs. LeftStart/LeftEnd and RightStart/RightEnd
+// are half-open indices, [Start, End), into the respective sequences; one of
+// the two ranges is empty unless Op is OpEqual or OpReplace.
+type Edit struct {
+	LeftStart, LeftEnd   int
+	RightStart, RightEnd int
+	Op                   OpKind
+}
+
+// Edits converts the LCS alignment of l into a minimal edit script: a
+// sequence of Edits that, applied in order, turns l.Left() into l.Right().
+// Consecutive matching elements are merged into a single OpEqual Edit.
+func Edits[Slice ~[]E, E any](l lcs.Lcs[Slice, E]) []Edit {
+	pairs := l.IndexPairs()
+	left, right := l.Left(), l.Right()
+
+	var edits []Edit
+	li, ri := 0, 0
+
+	addGap := func(leftEnd, rightEnd int) {
+		if leftEnd == li && rightEnd == ri {
+			return
+		}
+		edits = append(edits, gapEdit(li, leftEnd, ri, rightEnd))
+	}
+
+	for _, p := range pairs {
+		addGap(p.Left, p.Right)
+
+		if n := len(edits); n > 0 && edits[n-1].Op == OpEqual &&
+			edits[n-1].LeftEnd == p.Left && edits[n-1].RightEnd == p.Right {
+			edits[n-1].LeftEnd++
+			edits[n-1].RightEnd++
+		} else {
+			edits = append(edits, Edit{LeftStart: p.Left, LeftEnd: p.Left + 1, RightStart: p.Right, RightEnd: p.Right + 1, Op: OpEqual})
+		}
+		li, ri = p.Left+1, p.Right+1
+	}
+	addGap(len(left), len(right))
+
+	return edits
+}
+
+// gapEdit builds the Edit for a range with no matching elements, i.e. the
+// elements between two LCS matches (or before the first / after the last).
+func gapEdit(leftStart, leftEnd, rightStart, rightEnd int) Edit {
+	op := OpReplace
+	switch {
+	case leftStart == leftEnd:
+		op = OpInsert
+	case rightStart == rightEnd:
+		op = OpDelete
+	}
+	return Edit{LeftStart: leftStart, LeftEnd: leftEnd, RightStart: rightStart, RightEnd: rightEnd, Op: op}
+}
+
+// UnifiedOptions configures Unified's hunk rendering.
+type UnifiedOptions[E any] struct {
+	// Context is the number of unchanged elements kept around each change.
+	// Defaults to 3 when zero or negative.
+	Context int
+	// Formatter renders a single element as the text placed on a diff line.
+	// Defaults to fmt.Sprint when nil.
+	Formatter func(E) string
+}
+
+// Unified renders the LCS alignment of l as a unified diff: a sequence of
+// "@@ -leftStart,leftLength +rightStart,rightLength @@" hunk headers
+// followed by " ", "-" and "+" prefixed lines, in the style of `diff -u`.
+func Unified[Slice ~[]E, E any](l lcs.Lcs[Slice, E], opts UnifiedOptions[E]) string {
+	context := opts.Context
+	if context <= 0 {
+		context = 3
+	}
+	format := opts.Formatter
+	if format == nil {
+		format = func(e E) string { return fmt.Sprint(e) }
+	}
+
+	left, right := l.Left(), l.Right()
+	groups := groupHunks(Edits[Slice, E](l), context)
+
+	var b strings.Builder
+	for _, group := range groups {
+		writeHunk(&b, group, left, right, format)
+	}
+	return b.String()
+}
+
+// groupHunks splits edits into hunks, trimming the OpEqual edits at the
+// boundaries down to at most `context` elements and breaking into separate
+// hunks wherever two changes are more than 2*context elements apart. This
+// follows the same grouping rule as Python's difflib.get_grouped_opcodes.
+func groupHunks(edits []Edit, context int) [][]Edit {
+	if len(edits) == 0 {
+		return nil
+	}
+
+	codes := make([]Edit, len(edits))
+	copy(codes, edits)
+
+	if first := &codes[0]; first.Op == OpEqual {
+		first.LeftStart = max(first.LeftStart, first.LeftEnd-context)
+		first.RightStart = max(first.RightStart, first.RightEnd-context)
+	}
+	if last := &codes[len(codes)-1]; last.Op == OpEqual {
+		last.LeftEnd = min(last.LeftEnd, last.LeftStart+context)
+		last.RightEnd = min(last.RightEnd, last.RightStart+context)
+	}
+
+	var hunks [][]Edit
+	var group []Edit
+	for _, e := range codes {
+		if e.Op == OpEqual && e.LeftEnd-e.LeftStart > 2*context {
+			group = append(group, Edit{
+				Op:         OpEqual,
+				LeftStart:  e.LeftStart,
+				LeftEnd:    min(e.LeftEnd, e.LeftStart+context),
+				RightStart: e.RightStart,
+				RightEnd:   min(e.RightEnd, e.RightStart+context),
+			})
+			hunks = append(hunks, group)
+			group = nil
+			e.LeftStart = max(e.LeftStart, e.LeftEnd-context)
+			e.RightStart = max(e.RightStart, e.RightEnd-context)
+		}
+		group = append(group, e)
+	}
+	if len(group) > 0 && !(len(group) == 1 && group[0].Op == OpEqual) {
+		hunks = append(hunks, group)
+	}
+
+	return hunks
+}
+
+// writeHunk renders a single hunk, i.e. one "@@ ... @@" header and its body.
+func writeHunk[Slice ~[]E, E any](b *strings.Builder, group []Edit, left, right Slice, format func(E) string) {
+	first, last := group[0], group[len(group)-1]
+	fmt.Fprintf(b, "@@ -%s +%s @@\n",
+		hunkRange(first.LeftStart, last.LeftEnd),
+		hunkRange(first.RightStart, last.RightEnd))
+
+	for _, e := range group {
+		if e.Op == OpEqual {
+			for i := e.LeftStart; i < e.LeftEnd; i++ {
+				fmt.Fprintf(b, " %s\n", format(left[i]))
+			}
+			continue
+		}
+		if e.Op == OpDelete || e.Op == OpReplace {
+			for i := e.LeftStart; i < e.LeftEnd; i++ {
+				fmt.Fprintf(b, "-%s\n", format(left[i]))
+			}
+		}
+		if e.Op == OpInsert || e.Op == OpReplace {
+			for i := e.RightStart; i < e.RightEnd; i++ {
+				fmt.Fprintf(b, "+%s\n", format(right[i]))
+			}
+		}
+	}
+}
+
+// hunkRange formats a half-open [start, end) range as used in a unified
+// diff hunk header, which is 1-indexed and omits the length when it is 1.
+func hunkRange(start, end int) string {
+	length := end - start
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
@@ -0,0 +1,152 @@
+package diff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/WKBae/golcs"
+)
+
+func TestEditsMergesAndClassifies(t *testing.T) {
+	left := []string{"a", "b", "c", "d", "e", "f"}
+	right := []string{"a", "x", "c", "y", "z", "f"}
+
+	l := lcs.NewComparable[[]string](left, right)
+	edits := Edits[[]string](l)
+
+	want := []Edit{
+		{LeftStart: 0, LeftEnd: 1, RightStart: 0, RightEnd: 1, Op: OpEqual},
+		{LeftStart: 1, LeftEnd: 2, RightStart: 1, RightEnd: 2, Op: OpReplace},
+		{LeftStart: 2, LeftEnd: 3, RightStart: 2, RightEnd: 3, Op: OpEqual},
+		{LeftStart: 3, LeftEnd: 5, RightStart: 3, RightEnd: 5, Op: OpReplace},
+		{LeftStart: 5, LeftEnd: 6, RightStart: 5, RightEnd: 6, Op: OpEqual},
+	}
+
+	if len(edits) != len(want) {
+		t.Fatalf("Edits(): got %+v, want %+v", edits, want)
+	}
+	for i := range want {
+		if edits[i] != want[i] {
+			t.Errorf("Edits()[%d]: got %+v, want %+v", i, edits[i], want[i])
+		}
+	}
+}
+
+func TestEditsInsertAndDelete(t *testing.T) {
+	insLeft := []string{"a", "c"}
+	insRight := []string{"a", "b", "c"}
+	insEdits := Edits[[]string](lcs.NewComparable[[]string](insLeft, insRight))
+	wantIns := []Edit{
+		{LeftStart: 0, LeftEnd: 1, RightStart: 0, RightEnd: 1, Op: OpEqual},
+		{LeftStart: 1, LeftEnd: 1, RightStart: 1, RightEnd: 2, Op: OpInsert},
+		{LeftStart: 1, LeftEnd: 2, RightStart: 2, RightEnd: 3, Op: OpEqual},
+	}
+	if len(insEdits) != len(wantIns) {
+		t.Fatalf("Edits() (insert): got %+v, want %+v", insEdits, wantIns)
+	}
+	for i := range wantIns {
+		if insEdits[i] != wantIns[i] {
+			t.Errorf("Edits() (insert)[%d]: got %+v, want %+v", i, insEdits[i], wantIns[i])
+		}
+	}
+
+	delLeft := []string{"a", "b", "c"}
+	delRight := []string{"a", "c"}
+	delEdits := Edits[[]string](lcs.NewComparable[[]string](delLeft, delRight))
+	wantDel := []Edit{
+		{LeftStart: 0, LeftEnd: 1, RightStart: 0, RightEnd: 1, Op: OpEqual},
+		{LeftStart: 1, LeftEnd: 2, RightStart: 1, RightEnd: 1, Op: OpDelete},
+		{LeftStart: 2, LeftEnd: 3, RightStart: 1, RightEnd: 2, Op: OpEqual},
+	}
+	if len(delEdits) != len(wantDel) {
+		t.Fatalf("Edits() (delete): got %+v, want %+v", delEdits, wantDel)
+	}
+	for i := range wantDel {
+		if delEdits[i] != wantDel[i] {
+			t.Errorf("Edits() (delete)[%d]: got %+v, want %+v", i, delEdits[i], wantDel[i])
+		}
+	}
+}
+
+func TestEditsMergesConsecutiveEqualMatches(t *testing.T) {
+	left := []string{"a", "b", "c"}
+	right := []string{"a", "b", "c"}
+
+	edits := Edits[[]string](lcs.NewComparable[[]string](left, right))
+	want := []Edit{{LeftStart: 0, LeftEnd: 3, RightStart: 0, RightEnd: 3, Op: OpEqual}}
+
+	if len(edits) != len(want) || edits[0] != want[0] {
+		t.Fatalf("Edits(): got %+v, want %+v", edits, want)
+	}
+}
+
+func intFormatter(i int) string { return fmt.Sprint(i) }
+
+func TestUnifiedSplitsIntoSeparateHunks(t *testing.T) {
+	left := make([]int, 20)
+	for i := range left {
+		left[i] = i
+	}
+	right := append([]int(nil), left...)
+	right[2] = 100
+	right[17] = 200
+
+	l := lcs.NewComparable[[]int](left, right)
+	out := Unified[[]int](l, UnifiedOptions[int]{Context: 3, Formatter: intFormatter})
+
+	want := "@@ -1,6 +1,6 @@\n" +
+		" 0\n 1\n-2\n+100\n 3\n 4\n 5\n" +
+		"@@ -15,6 +15,6 @@\n" +
+		" 14\n 15\n 16\n-17\n+200\n 18\n 19\n"
+
+	if out != want {
+		t.Errorf("Unified():\ngot:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestUnifiedTrimsContextAtBothEnds(t *testing.T) {
+	left := make([]int, 10)
+	for i := range left {
+		left[i] = i
+	}
+	right := append([]int(nil), left...)
+	right[0] = 100
+	right[9] = 200
+
+	l := lcs.NewComparable[[]int](left, right)
+	out := Unified[[]int](l, UnifiedOptions[int]{Context: 3, Formatter: intFormatter})
+
+	want := "@@ -1,4 +1,4 @@\n" +
+		"-0\n+100\n 1\n 2\n 3\n" +
+		"@@ -7,4 +7,4 @@\n" +
+		" 6\n 7\n 8\n-9\n+200\n"
+
+	if out != want {
+		t.Errorf("Unified():\ngot:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestUnifiedDefaultFormatter(t *testing.T) {
+	left := []int{1, 2, 3}
+	right := []int{1, 9, 3}
+
+	l := lcs.NewComparable[[]int](left, right)
+	out := Unified[[]int](l, UnifiedOptions[int]{})
+
+	want := "@@ -1,3 +1,3 @@\n 1\n-2\n+9\n 3\n"
+	if out != want {
+		t.Errorf("Unified() with nil Formatter:\ngot:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestUnifiedEmptyDiffProducesNoHunks(t *testing.T) {
+	left := []int{1, 2, 3}
+	right := []int{1, 2, 3}
+
+	l := lcs.NewComparable[[]int](left, right)
+	out := Unified[[]int](l, UnifiedOptions[int]{})
+
+	if out != "" {
+		t.Errorf("Unified() on equal input: got %q, want empty string", out)
+	}
+}
@@ -0,0 +1,26 @@
+package lcs
+
+// EqualFunc reports whether a and b should be considered equal for the
+// purpose of computing an LCS.
+type EqualFunc[E any] func(a, b E) bool
+
+// NewFunc creates a new LCS calculator from two arrays, comparing elements
+// with a custom equality function instead of reflect.DeepEqual. This is
+// useful for case-insensitive string comparisons, tolerance based float
+// matching, or comparing structs by a key field.
+func NewFunc[Slice ~[]E, E any](left, right Slice, eq EqualFunc[E]) Lcs[Slice, E] {
+	return &lcs[Slice, E]{
+		left:  left,
+		right: right,
+		eq:    eq,
+	}
+}
+
+// NewComparable creates a new LCS calculator from two arrays of a comparable
+// type, comparing elements with == instead of reflect.DeepEqual. This is
+// typically much faster than New for []string, []int and similar slices.
+func NewComparable[Slice ~[]E, E comparable](left, right Slice) Lcs[Slice, E] {
+	return NewFunc[Slice, E](left, right, func(a, b E) bool {
+		return a == b
+	})
+}
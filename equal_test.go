@@ -0,0 +1,60 @@
+package lcs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewFuncCustomEqual(t *testing.T) {
+	left := []string{"Foo", "Bar", "Baz"}
+	right := []string{"foo", "qux", "baz"}
+
+	l := NewFunc[[]string](left, right, func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	})
+
+	if got, want := l.Length(), 2; got != want {
+		t.Errorf("Length(): got %d, want %d", got, want)
+	}
+
+	values := l.Values()
+	want := []string{"Foo", "Baz"}
+	if len(values) != len(want) {
+		t.Fatalf("Values(): got %v, want %v", values, want)
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Fatalf("Values(): got %v, want %v", values, want)
+		}
+	}
+}
+
+func TestNewComparableMatchesNew(t *testing.T) {
+	intCases := [][2][]int{
+		{{1, 2, 3, 4}, {1, 3, 4}},
+		{{}, {1, 2}},
+		{{1, 2, 3}, {}},
+		{{1, 1, 2, 2}, {1, 2, 1, 2}},
+	}
+	for _, c := range intCases {
+		left, right := c[0], c[1]
+		want := New[[]int](left, right).Length()
+		got := NewComparable[[]int](left, right).Length()
+		if got != want {
+			t.Errorf("Length(%v, %v): got %d, want %d", left, right, got, want)
+		}
+	}
+
+	stringCases := [][2][]string{
+		{{"a", "b", "c"}, {"a", "c"}},
+		{{"x", "y"}, {"y", "x"}},
+	}
+	for _, c := range stringCases {
+		left, right := c[0], c[1]
+		want := New[[]string](left, right).Length()
+		got := NewComparable[[]string](left, right).Length()
+		if got != want {
+			t.Errorf("Length(%v, %v): got %d, want %d", left, right, got, want)
+		}
+	}
+}
@@ -36,21 +36,22 @@ type IndexPair struct {
 type lcs[Slice ~[]E, E any] struct {
 	left  Slice
 	right Slice
+
+	eq EqualFunc[E]
+
 	/* for caching */
 	table      [][]int
 	indexPairs []IndexPair
 	values     Slice
 }
 
-// New creates a new LCS calculator from two arrays.
+// New creates a new LCS calculator from two arrays, comparing elements with
+// reflect.DeepEqual. Use NewComparable or NewFunc for faster or customized
+// equality.
 func New[Slice ~[]E, E any](left, right Slice) Lcs[Slice, E] {
-	return &lcs[Slice, E]{
-		left:       left,
-		right:      right,
-		table:      nil,
-		indexPairs: nil,
-		values:     nil,
-	}
+	return NewFunc[Slice, E](left, right, func(a, b E) bool {
+		return reflect.DeepEqual(a, b)
+	})
 }
 
 // Table implements Lcs.Table()
@@ -82,7 +83,7 @@ func (lcs *lcs[Slice, E]) TableContext(ctx context.Context) (table [][]int, err
 		}
 		for x := 1; x < sizeX; x++ {
 			increment := 0
-			if reflect.DeepEqual(lcs.left[x-1], lcs.right[y-1]) {
+			if lcs.eq(lcs.left[x-1], lcs.right[y-1]) {
 				increment = 1
 			}
 			table[x][y] = max(table[x-1][y-1]+increment, table[x-1][y], table[x][y-1])
@@ -128,7 +129,7 @@ func (lcs *lcs[Slice, E]) IndexPairsContext(ctx context.Context) (pairs []IndexP
 	pairs = make([]IndexPair, table[len(table)-1][len(table[0])-1])
 
 	for x, y := len(lcs.left), len(lcs.right); x > 0 && y > 0; {
-		if reflect.DeepEqual(lcs.left[x-1], lcs.right[y-1]) {
+		if lcs.eq(lcs.left[x-1], lcs.right[y-1]) {
 			pairs[table[x][y]-1] = IndexPair{Left: x - 1, Right: y - 1}
 			x--
 			y--
@@ -0,0 +1,230 @@
+package lcs
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// ErrTableUnsupported is returned by TableContext on backends that never
+// materialize a full DP table, such as hirschberg.
+var ErrTableUnsupported = errors.New("lcs: Table is not supported by this backend")
+
+// hirschberg is a Lcs implementation based on Hirschberg's divide-and-conquer
+// algorithm. It computes an LCS of the same length as the table based lcs
+// (though not necessarily the same one) in O(NM) time, but using only O(N+M)
+// memory instead of O(NM), at the cost of never
+// keeping a full DP table around, so Table/TableContext are unsupported.
+type hirschberg[Slice ~[]E, E any] struct {
+	left  Slice
+	right Slice
+
+	eq EqualFunc[E]
+
+	/* for caching */
+	indexPairs []IndexPair
+	values     Slice
+}
+
+// NewHirschberg creates a new LCS calculator from two arrays using
+// Hirschberg's linear-space algorithm. It is intended for very large inputs
+// where the O(NM) memory used by New's DP table is not practical; Length and
+// IndexPairs still take O(NM) time, but only O(N+M) memory.
+func NewHirschberg[Slice ~[]E, E any](left, right Slice) Lcs[Slice, E] {
+	return NewHirschbergFunc[Slice, E](left, right, func(a, b E) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// NewHirschbergFunc creates a new Hirschberg backed LCS calculator,
+// comparing elements with a custom equality function instead of
+// reflect.DeepEqual.
+func NewHirschbergFunc[Slice ~[]E, E any](left, right Slice, eq EqualFunc[E]) Lcs[Slice, E] {
+	return &hirschberg[Slice, E]{
+		left:  left,
+		right: right,
+		eq:    eq,
+	}
+}
+
+// NewHirschbergComparable creates a new Hirschberg backed LCS calculator
+// from two arrays of a comparable type, comparing elements with == instead
+// of reflect.DeepEqual.
+func NewHirschbergComparable[Slice ~[]E, E comparable](left, right Slice) Lcs[Slice, E] {
+	return NewHirschbergFunc[Slice, E](left, right, func(a, b E) bool {
+		return a == b
+	})
+}
+
+// Table is unsupported by hirschberg, since it never materializes a full DP
+// table; it always returns nil.
+func (h *hirschberg[Slice, E]) Table() (table [][]int) {
+	return nil
+}
+
+// TableContext is unsupported by hirschberg, since it never materializes a
+// full DP table; it always returns ErrTableUnsupported.
+func (h *hirschberg[Slice, E]) TableContext(ctx context.Context) (table [][]int, err error) {
+	return nil, ErrTableUnsupported
+}
+
+// Length implements Lcs.Length()
+func (h *hirschberg[Slice, E]) Length() (length int) {
+	length, _ = h.LengthContext(context.Background())
+	return length
+}
+
+// LengthContext implements Lcs.LengthContext()
+func (h *hirschberg[Slice, E]) LengthContext(ctx context.Context) (length int, err error) {
+	pairs, err := h.IndexPairsContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(pairs), nil
+}
+
+// IndexPairs implements Lcs.IndexPairs()
+func (h *hirschberg[Slice, E]) IndexPairs() (pairs []IndexPair) {
+	pairs, _ = h.IndexPairsContext(context.Background())
+	return pairs
+}
+
+// IndexPairsContext implements Lcs.IndexPairsContext()
+func (h *hirschberg[Slice, E]) IndexPairsContext(ctx context.Context) (pairs []IndexPair, err error) {
+	if h.indexPairs != nil {
+		return h.indexPairs, nil
+	}
+
+	pairs, err = h.align(ctx, 0, len(h.left), 0, len(h.right))
+	if err != nil {
+		return nil, err
+	}
+
+	h.indexPairs = pairs
+	return pairs, nil
+}
+
+// align computes the IndexPairs between h.left[l1:l2] and h.right[r1:r2],
+// recursing on the two halves split at the column that maximizes the
+// combined forward/backward LCS length, per Hirschberg's algorithm.
+func (h *hirschberg[Slice, E]) align(ctx context.Context, l1, l2, r1, r2 int) (pairs []IndexPair, err error) {
+	select { // check at each recursion level to save some time
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// nop
+	}
+
+	n := l2 - l1
+	m := r2 - r1
+
+	if n == 0 || m == 0 {
+		return nil, nil
+	}
+	if n == 1 {
+		for j := r1; j < r2; j++ {
+			if h.eq(h.left[l1], h.right[j]) {
+				return []IndexPair{{Left: l1, Right: j}}, nil
+			}
+		}
+		return nil, nil
+	}
+
+	mid := l1 + n/2
+
+	forward := h.row(h.left[l1:mid], h.right[r1:r2])
+	backward := h.row(reverseSlice[Slice](h.left[mid:l2]), reverseSlice[Slice](h.right[r1:r2]))
+
+	split := r1 + bestSplit(forward, backward)
+
+	firstHalf, err := h.align(ctx, l1, mid, r1, split)
+	if err != nil {
+		return nil, err
+	}
+	secondHalf, err := h.align(ctx, mid, l2, split, r2)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(firstHalf, secondHalf...), nil
+}
+
+// row computes the last row of the DP table for left against right, i.e.
+// for every prefix length j of right, the LCS length of left and
+// right[:j], using two rolling rows instead of a full table.
+func (h *hirschberg[Slice, E]) row(left, right Slice) []int {
+	prev := make([]int, len(right)+1)
+	curr := make([]int, len(right)+1)
+
+	for i := 1; i <= len(left); i++ {
+		for j := 1; j <= len(right); j++ {
+			if h.eq(left[i-1], right[j-1]) {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = max(prev[j], curr[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev
+}
+
+// bestSplit finds the first index j, 0 <= j < len(forward), that maximizes forward[j] + backward[len(backward)-1-j], i.e. the column that
+// splits right into the best matching halves for the two rows produced by
+// row().
+func bestSplit(forward, backward []int) int {
+	m := len(forward) - 1
+	bestJ, best := 0, -1
+	for j := 0; j <= m; j++ {
+		if score := forward[j] + backward[m-j]; score > best {
+			best, bestJ = score, j
+		}
+	}
+	return bestJ
+}
+
+// reverseSlice returns a new Slice with the elements of s in reverse order.
+func reverseSlice[Slice ~[]E, E any](s Slice) Slice {
+	out := make(Slice, len(s))
+	for i, v := range s {
+		out[len(s)-1-i] = v
+	}
+	return out
+}
+
+// Values implements Lcs.Values()
+func (h *hirschberg[Slice, E]) Values() (values Slice) {
+	values, _ = h.ValuesContext(context.Background())
+	return values
+}
+
+// ValuesContext implements Lcs.ValuesContext()
+func (h *hirschberg[Slice, E]) ValuesContext(ctx context.Context) (values Slice, err error) {
+	if h.values != nil {
+		return h.values, nil
+	}
+
+	pairs, err := h.IndexPairsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values = make(Slice, len(pairs))
+	for i, pair := range pairs {
+		values[i] = h.left[pair.Left]
+	}
+	h.values = values
+
+	return values, nil
+}
+
+// Left implements Lcs.Left()
+func (h *hirschberg[Slice, E]) Left() (leftValues Slice) {
+	return h.left
+}
+
+// Right implements Lcs.Right()
+func (h *hirschberg[Slice, E]) Right() (rightValues Slice) {
+	return h.right
+}
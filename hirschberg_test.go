@@ -0,0 +1,64 @@
+package lcs
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+)
+
+func TestHirschbergMatchesTable(t *testing.T) {
+	cases := []struct {
+		left, right string
+	}{
+		{"ABCABBA", "CBABAC"},
+		{"", ""},
+		{"a", ""},
+		{"", "a"},
+		{"a", "a"},
+		{"a", "b"},
+		{"abc", "abc"},
+		{"abcdef", "zbdf"},
+		{"xxxxxx", "xxxxxx"},
+		{"thisisatest", "testing123testing"},
+	}
+
+	for _, c := range cases {
+		left, right := []byte(c.left), []byte(c.right)
+		want := New[[]byte](left, right).Length()
+		got := NewHirschberg[[]byte](left, right).Length()
+		if got != want {
+			t.Errorf("Length(%q, %q): got %d, want %d", c.left, c.right, got, want)
+		}
+	}
+}
+
+func TestHirschbergMatchesTableRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	alphabet := []byte("ABCD")
+
+	for trial := 0; trial < 200; trial++ {
+		left := randomBytes(r, alphabet, r.Intn(30))
+		right := randomBytes(r, alphabet, r.Intn(30))
+
+		want := New[[]byte](left, right).Length()
+		got := NewHirschberg[[]byte](left, right).Length()
+		if got != want {
+			t.Fatalf("Length(%q, %q): got %d, want %d", left, right, got, want)
+		}
+
+		assertValidAlignment(t, left, right, NewHirschberg[[]byte](left, right).IndexPairs())
+	}
+}
+
+func TestHirschbergTableUnsupported(t *testing.T) {
+	h := NewHirschberg[[]byte]([]byte("a"), []byte("b")).(*hirschberg[[]byte, byte])
+
+	if table := h.Table(); table != nil {
+		t.Errorf("Table(): got %v, want nil", table)
+	}
+
+	_, err := h.TableContext(context.Background())
+	if err != ErrTableUnsupported {
+		t.Errorf("TableContext(): got err %v, want %v", err, ErrTableUnsupported)
+	}
+}
@@ -0,0 +1,230 @@
+package lcs
+
+import (
+	"context"
+	"reflect"
+)
+
+// myers is a Lcs implementation based on Myers' O(ND) greedy diff algorithm,
+// where D is the edit distance between the two arrays. It is significantly
+// faster and lighter on memory than the table based lcs when the two arrays
+// are largely similar, at the cost of being slower than the table based lcs
+// when they are mostly dissimilar.
+type myers[Slice ~[]E, E any] struct {
+	left  Slice
+	right Slice
+
+	eq EqualFunc[E]
+
+	/* for caching */
+	indexPairs []IndexPair
+	values     Slice
+}
+
+// NewMyers creates a new LCS calculator from two arrays using Myers'
+// O(ND) greedy diff algorithm, where D is the edit distance between left
+// and right. Unlike New, it does not build a full DP table, so it is much
+// faster and lighter on memory for large, mostly-similar inputs.
+func NewMyers[Slice ~[]E, E any](left, right Slice) Lcs[Slice, E] {
+	return NewMyersFunc[Slice, E](left, right, func(a, b E) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// NewMyersFunc creates a new Myers backed LCS calculator, comparing
+// elements with a custom equality function instead of reflect.DeepEqual.
+func NewMyersFunc[Slice ~[]E, E any](left, right Slice, eq EqualFunc[E]) Lcs[Slice, E] {
+	return &myers[Slice, E]{
+		left:  left,
+		right: right,
+		eq:    eq,
+	}
+}
+
+// NewMyersComparable creates a new Myers backed LCS calculator from two
+// arrays of a comparable type, comparing elements with == instead of
+// reflect.DeepEqual.
+func NewMyersComparable[Slice ~[]E, E comparable](left, right Slice) Lcs[Slice, E] {
+	return NewMyersFunc[Slice, E](left, right, func(a, b E) bool {
+		return a == b
+	})
+}
+
+// Length implements Lcs.Length()
+func (myers *myers[Slice, E]) Length() (length int) {
+	length, _ = myers.LengthContext(context.Background())
+	return length
+}
+
+// LengthContext implements Lcs.LengthContext()
+func (myers *myers[Slice, E]) LengthContext(ctx context.Context) (length int, err error) {
+	pairs, err := myers.IndexPairsContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(pairs), nil
+}
+
+// IndexPairs implements Lcs.IndexPairs()
+func (myers *myers[Slice, E]) IndexPairs() (pairs []IndexPair) {
+	pairs, _ = myers.IndexPairsContext(context.Background())
+	return pairs
+}
+
+// IndexPairsContext implements Lcs.IndexPairsContext()
+func (myers *myers[Slice, E]) IndexPairsContext(ctx context.Context) (pairs []IndexPair, err error) {
+	if myers.indexPairs != nil {
+		return myers.indexPairs, nil
+	}
+
+	pairs, err = myers.shortestEditScript(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	myers.indexPairs = pairs
+	return pairs, nil
+}
+
+// shortestEditScript runs Myers' O(ND) algorithm, recording a trace of the
+// diagonal vector V at each edit distance D so the matching diagonals can be
+// recovered afterwards by backtrack.
+func (myers *myers[Slice, E]) shortestEditScript(ctx context.Context) (pairs []IndexPair, err error) {
+	n, m := len(myers.left), len(myers.right)
+	max := n + m
+	if max == 0 {
+		return []IndexPair{}, nil
+	}
+
+	v := make([]int, 2*max+1)
+	offset := max
+	trace := make([][]int, 0, max+1)
+
+	for d := 0; d <= max; d++ {
+		select { // check in each D to save some time
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// nop
+		}
+
+		// Backtracking from step d only ever reads diagonals k in
+		// [-(d-1), d-1], i.e. whatever the previous step populated, so
+		// snapshot just that window instead of the whole O(N+M) vector.
+		// Summed over all steps this keeps the trace at O(D^2) instead of
+		// O(D*(N+M)); d == 0 needs no snapshot at all since backtrack
+		// special-cases it.
+		var snapshot []int
+		if d > 0 {
+			snapshot = make([]int, 2*d-1)
+			copy(snapshot, v[offset-d+1:offset+d])
+		}
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+
+			for x < n && y < m && myers.eq(myers.left[x], myers.right[y]) {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return myers.backtrack(trace, d, n, m), nil
+			}
+		}
+	}
+
+	// unreachable: the loop above always finds a solution by d == max
+	return nil, nil
+}
+
+// backtrack walks the trace recorded by shortestEditScript from (n, m) back
+// to the origin, collecting an IndexPair for every diagonal (matching) step
+// along the way. trace[d] (d > 0) holds only diagonals k in [-(d-1), d-1],
+// indexed locally as k+(d-1); d == 0 is handled directly since the only way
+// to reach it is a run of diagonal moves from the origin.
+func (myers *myers[Slice, E]) backtrack(trace [][]int, finalD, n, m int) []IndexPair {
+	x, y := n, m
+	var pairs []IndexPair
+
+	for d := finalD; d >= 0; d-- {
+		var prevX, prevY int
+
+		if d == 0 {
+			prevX, prevY = 0, 0
+		} else {
+			v := trace[d]
+			k := x - y
+			local := d - 1
+
+			var prevK int
+			if k == -d || (k != d && v[k-1+local] < v[k+1+local]) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+
+			prevX = v[prevK+local]
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			pairs = append(pairs, IndexPair{Left: x, Right: y})
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(pairs)-1; i < j; i, j = i+1, j-1 {
+		pairs[i], pairs[j] = pairs[j], pairs[i]
+	}
+
+	return pairs
+}
+
+// Values implements Lcs.Values()
+func (myers *myers[Slice, E]) Values() (values Slice) {
+	values, _ = myers.ValuesContext(context.Background())
+	return values
+}
+
+// ValuesContext implements Lcs.ValuesContext()
+func (myers *myers[Slice, E]) ValuesContext(ctx context.Context) (values Slice, err error) {
+	if myers.values != nil {
+		return myers.values, nil
+	}
+
+	pairs, err := myers.IndexPairsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values = make(Slice, len(pairs))
+	for i, pair := range pairs {
+		values[i] = myers.left[pair.Left]
+	}
+	myers.values = values
+
+	return values, nil
+}
+
+// Left implements Lcs.Left()
+func (myers *myers[Slice, E]) Left() (leftValues Slice) {
+	return myers.left
+}
+
+// Right implements Lcs.Right()
+func (myers *myers[Slice, E]) Right() (rightValues Slice) {
+	return myers.right
+}
@@ -0,0 +1,75 @@
+package lcs
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMyersMatchesTable(t *testing.T) {
+	cases := []struct {
+		left, right string
+	}{
+		{"ABCABBA", "CBABAC"},
+		{"", ""},
+		{"abc", ""},
+		{"", "abc"},
+		{"abc", "abc"},
+		{"abcdef", "zbdf"},
+		{"xxxxxx", "xxxxxx"},
+		{"a", "a"},
+		{"a", "b"},
+	}
+
+	for _, c := range cases {
+		left, right := []byte(c.left), []byte(c.right)
+		want := New[[]byte](left, right).Length()
+		got := NewMyers[[]byte](left, right).Length()
+		if got != want {
+			t.Errorf("Length(%q, %q): got %d, want %d", c.left, c.right, got, want)
+		}
+	}
+}
+
+func TestMyersMatchesTableRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(99))
+	alphabet := []byte("ABCD")
+
+	for trial := 0; trial < 200; trial++ {
+		left := randomBytes(r, alphabet, r.Intn(40))
+		right := randomBytes(r, alphabet, r.Intn(40))
+
+		want := New[[]byte](left, right).Length()
+		got := NewMyers[[]byte](left, right).Length()
+		if got != want {
+			t.Fatalf("Length(%q, %q): got %d, want %d", left, right, got, want)
+		}
+
+		assertValidAlignment(t, left, right, NewMyers[[]byte](left, right).IndexPairs())
+	}
+}
+
+// randomBytes returns a random byte slice of length n drawn from alphabet.
+func randomBytes(r *rand.Rand, alphabet []byte, n int) []byte {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return out
+}
+
+// assertValidAlignment fails t if pairs is not a strictly increasing,
+// matching alignment between left and right.
+func assertValidAlignment(t *testing.T, left, right []byte, pairs []IndexPair) {
+	t.Helper()
+
+	lastLeft, lastRight := -1, -1
+	for _, p := range pairs {
+		if p.Left <= lastLeft || p.Right <= lastRight {
+			t.Fatalf("non-monotonic pairs for %q/%q: %v", left, right, pairs)
+		}
+		if left[p.Left] != right[p.Right] {
+			t.Fatalf("mismatched pair %v for %q/%q", p, left, right)
+		}
+		lastLeft, lastRight = p.Left, p.Right
+	}
+}
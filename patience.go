@@ -0,0 +1,233 @@
+package lcs
+
+import (
+	"context"
+)
+
+// patience is a Lcs implementation based on Bram Cohen's patience diff
+// algorithm. It tends to produce more human-friendly alignments than the
+// minimal LCS on source-code-like inputs, since it anchors the diff on
+// elements that are unique on both sides instead of matching every
+// occurrence of repeated elements such as stray braces.
+type patience[Slice ~[]E, E comparable] struct {
+	left  Slice
+	right Slice
+
+	/* for caching */
+	indexPairs []IndexPair
+	values     Slice
+}
+
+// NewPatience creates a new LCS calculator from two arrays using the
+// patience diff algorithm. It requires E to be comparable, since it relies
+// on hashing elements to find the ones that occur exactly once on each
+// side.
+func NewPatience[Slice ~[]E, E comparable](left, right Slice) Lcs[Slice, E] {
+	return &patience[Slice, E]{
+		left:  left,
+		right: right,
+	}
+}
+
+// Length implements Lcs.Length()
+func (p *patience[Slice, E]) Length() (length int) {
+	length, _ = p.LengthContext(context.Background())
+	return length
+}
+
+// LengthContext implements Lcs.LengthContext()
+func (p *patience[Slice, E]) LengthContext(ctx context.Context) (length int, err error) {
+	pairs, err := p.IndexPairsContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(pairs), nil
+}
+
+// IndexPairs implements Lcs.IndexPairs()
+func (p *patience[Slice, E]) IndexPairs() (pairs []IndexPair) {
+	pairs, _ = p.IndexPairsContext(context.Background())
+	return pairs
+}
+
+// IndexPairsContext implements Lcs.IndexPairsContext()
+func (p *patience[Slice, E]) IndexPairsContext(ctx context.Context) (pairs []IndexPair, err error) {
+	if p.indexPairs != nil {
+		return p.indexPairs, nil
+	}
+
+	pairs, err = patienceAlign[Slice, E](ctx, p.left, p.right, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p.indexPairs = pairs
+	return pairs, nil
+}
+
+// patienceAlign aligns left against right and returns IndexPairs with
+// indices shifted by leftOffset/rightOffset, so it can be called
+// recursively on sub-slices while still reporting indices into the
+// original arrays.
+func patienceAlign[Slice ~[]E, E comparable](ctx context.Context, left, right Slice, leftOffset, rightOffset int) (pairs []IndexPair, err error) {
+	select { // check at each recursion level to save some time
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// nop
+	}
+
+	if len(left) == 0 || len(right) == 0 {
+		return nil, nil
+	}
+
+	anchors := uniqueCommonAnchors[Slice, E](left, right)
+	if len(anchors) == 0 {
+		// No unique common elements to split on; fall back to Myers on
+		// this gap.
+		gap, err := NewMyersComparable[Slice, E](left, right).IndexPairsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return offsetPairs(gap, leftOffset, rightOffset), nil
+	}
+
+	prevLeft, prevRight := 0, 0
+	for _, a := range anchors {
+		gap, err := patienceAlign[Slice, E](ctx, left[prevLeft:a.Left], right[prevRight:a.Right], leftOffset+prevLeft, rightOffset+prevRight)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, gap...)
+		pairs = append(pairs, IndexPair{Left: leftOffset + a.Left, Right: rightOffset + a.Right})
+		prevLeft, prevRight = a.Left+1, a.Right+1
+	}
+
+	tail, err := patienceAlign[Slice, E](ctx, left[prevLeft:], right[prevRight:], leftOffset+prevLeft, rightOffset+prevRight)
+	if err != nil {
+		return nil, err
+	}
+	pairs = append(pairs, tail...)
+
+	return pairs, nil
+}
+
+// uniqueCommonAnchors finds the elements that occur exactly once in both
+// left and right, then keeps only the longest increasing subsequence of
+// their right-indices in left-order, via patience sorting. The result is a
+// set of index pairs increasing in both Left and Right, so they can all be
+// part of one common subsequence; they are not necessarily part of a
+// longest one.
+func uniqueCommonAnchors[Slice ~[]E, E comparable](left, right Slice) []IndexPair {
+	leftCount := make(map[E]int, len(left))
+	for _, v := range left {
+		leftCount[v]++
+	}
+
+	rightCount := make(map[E]int, len(right))
+	rightIndex := make(map[E]int, len(right))
+	for i, v := range right {
+		rightCount[v]++
+		rightIndex[v] = i
+	}
+
+	var candidates []IndexPair
+	for i, v := range left {
+		if leftCount[v] == 1 && rightCount[v] == 1 {
+			candidates = append(candidates, IndexPair{Left: i, Right: rightIndex[v]})
+		}
+	}
+
+	return longestIncreasingByRight(candidates)
+}
+
+// longestIncreasingByRight returns the longest subsequence of candidates,
+// which are assumed to already be sorted by Left, that is strictly
+// increasing in Right. It uses patience sorting: a binary search over the
+// smallest-tail-so-far of each subsequence length, giving O(k log k) for
+// k candidates.
+func longestIncreasingByRight(candidates []IndexPair) []IndexPair {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	tails := make([]int, 0, len(candidates)) // tails[k] = index into candidates of the smallest tail of an increasing run of length k+1
+	pred := make([]int, len(candidates))
+
+	for i, c := range candidates {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if candidates[tails[mid]].Right < c.Right {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+
+		if lo > 0 {
+			pred[i] = tails[lo-1]
+		} else {
+			pred[i] = -1
+		}
+
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	result := make([]IndexPair, len(tails))
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i-- {
+		result[i] = candidates[k]
+		k = pred[k]
+	}
+
+	return result
+}
+
+// offsetPairs shifts every IndexPair in pairs by leftOffset/rightOffset.
+func offsetPairs(pairs []IndexPair, leftOffset, rightOffset int) []IndexPair {
+	out := make([]IndexPair, len(pairs))
+	for i, p := range pairs {
+		out[i] = IndexPair{Left: p.Left + leftOffset, Right: p.Right + rightOffset}
+	}
+	return out
+}
+
+// Values implements Lcs.Values()
+func (p *patience[Slice, E]) Values() (values Slice) {
+	values, _ = p.ValuesContext(context.Background())
+	return values
+}
+
+// ValuesContext implements Lcs.ValuesContext()
+func (p *patience[Slice, E]) ValuesContext(ctx context.Context) (values Slice, err error) {
+	if p.values != nil {
+		return p.values, nil
+	}
+
+	pairs, err := p.IndexPairsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values = make(Slice, len(pairs))
+	for i, pair := range pairs {
+		values[i] = p.left[pair.Left]
+	}
+	p.values = values
+
+	return values, nil
+}
+
+// Left implements Lcs.Left()
+func (p *patience[Slice, E]) Left() (leftValues Slice) {
+	return p.left
+}
+
+// Right implements Lcs.Right()
+func (p *patience[Slice, E]) Right() (rightValues Slice) {
+	return p.right
+}
@@ -0,0 +1,45 @@
+package lcs
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPatienceAnchors(t *testing.T) {
+	left := []string{"a", "b", "c", "d", "e"}
+	right := []string{"a", "x", "c", "d", "y"}
+
+	pairs := NewPatience[[]string](left, right).IndexPairs()
+	want := []IndexPair{{0, 0}, {2, 2}, {3, 3}}
+	if len(pairs) != len(want) {
+		t.Fatalf("IndexPairs(): got %v, want %v", pairs, want)
+	}
+	for i := range want {
+		if pairs[i] != want[i] {
+			t.Fatalf("IndexPairs(): got %v, want %v", pairs, want)
+		}
+	}
+}
+
+func TestPatienceFallsBackWithoutUniqueAnchors(t *testing.T) {
+	// every element repeats, so there is no unique anchor to split on and
+	// the whole thing falls back to Myers.
+	left := []string{"a", "a", "a"}
+	right := []string{"a", "a"}
+
+	if got, want := NewPatience[[]string](left, right).Length(), 2; got != want {
+		t.Errorf("Length(): got %d, want %d", got, want)
+	}
+}
+
+func TestPatienceValidAlignmentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	alphabet := []byte("ABCDEF")
+
+	for trial := 0; trial < 200; trial++ {
+		left := randomBytes(r, alphabet, r.Intn(30))
+		right := randomBytes(r, alphabet, r.Intn(30))
+
+		assertValidAlignment(t, left, right, NewPatience[[]byte](left, right).IndexPairs())
+	}
+}
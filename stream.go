@@ -0,0 +1,134 @@
+package lcs
+
+import (
+	"reflect"
+)
+
+// StreamLCS incrementally aligns two streams of elements using a bounded
+// sliding window, for cases where materializing both full inputs in memory,
+// as Lcs does, is infeasible, e.g. diffing large log streams or network
+// feeds. It trades accuracy for memory: an element can only be matched
+// against elements of the other stream that are buffered at the same time,
+// so some matches that a full LCS would find are missed.
+type StreamLCS[E any] interface {
+	// PushLeft appends an element to the left stream.
+	PushLeft(e E)
+	// PushRight appends an element to the right stream.
+	PushRight(e E)
+	// Snapshot returns the IndexPairs found so far, with indices relative
+	// to the start of the streams rather than the current window.
+	Snapshot() []IndexPair
+	// Flush aligns and returns the remaining buffered elements, as
+	// Snapshot does, then resets the streams to empty.
+	Flush() []IndexPair
+}
+
+// NewStreamLCS creates a StreamLCS that keeps at most 2*window elements of
+// each stream buffered at a time, trimming each back down to window elements
+// whenever it grows past that, comparing elements with reflect.DeepEqual.
+func NewStreamLCS[E any](window int) StreamLCS[E] {
+	return NewStreamLCSFunc[E](window, func(a, b E) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// NewStreamLCSFunc creates a StreamLCS that keeps at most 2*window elements
+// of each stream buffered at a time, trimming each back down to window
+// elements whenever it grows past that, comparing elements with eq. A
+// negative window is treated as zero.
+func NewStreamLCSFunc[E any](window int, eq EqualFunc[E]) StreamLCS[E] {
+	if window < 0 {
+		window = 0
+	}
+	return &streamLCS[E]{
+		window: window,
+		eq:     eq,
+	}
+}
+
+type streamLCS[E any] struct {
+	window int
+	eq     EqualFunc[E]
+
+	left, right         []E
+	leftBase, rightBase int
+
+	committed []IndexPair
+}
+
+// PushLeft implements StreamLCS.PushLeft()
+func (s *streamLCS[E]) PushLeft(e E) {
+	s.left = append(s.left, e)
+	s.maybeCompact()
+}
+
+// PushRight implements StreamLCS.PushRight()
+func (s *streamLCS[E]) PushRight(e E) {
+	s.right = append(s.right, e)
+	s.maybeCompact()
+}
+
+// maybeCompact lets each buffer grow to twice the window before doing any
+// work, then aligns and trims both back down to the window in one pass.
+// Since compact is O(W^2) and runs once every ~W pushes, pushing stays
+// O(W) amortized instead of re-aligning the whole window on every element.
+func (s *streamLCS[E]) maybeCompact() {
+	if len(s.left) > 2*s.window || len(s.right) > 2*s.window {
+		s.compact()
+	}
+}
+
+// compact aligns the current window and commits every match that lies
+// entirely within the prefix being dropped, then trims both buffers back
+// down to at most s.window elements. A match whose partner is not also
+// being dropped is simply left unmatched; this is the accuracy/memory
+// tradeoff a bounded window implies.
+func (s *streamLCS[E]) compact() {
+	dropLeft := 0
+	if len(s.left) > s.window {
+		dropLeft = len(s.left) - s.window
+	}
+	dropRight := 0
+	if len(s.right) > s.window {
+		dropRight = len(s.right) - s.window
+	}
+
+	for _, p := range s.align() {
+		if p.Left < dropLeft && p.Right < dropRight {
+			s.committed = append(s.committed, IndexPair{Left: s.leftBase + p.Left, Right: s.rightBase + p.Right})
+		}
+	}
+
+	s.left = s.left[dropLeft:]
+	s.leftBase += dropLeft
+	s.right = s.right[dropRight:]
+	s.rightBase += dropRight
+}
+
+// align computes the DP alignment of the current window.
+func (s *streamLCS[E]) align() []IndexPair {
+	return NewFunc[[]E, E](s.left, s.right, s.eq).IndexPairs()
+}
+
+// Snapshot implements StreamLCS.Snapshot()
+func (s *streamLCS[E]) Snapshot() []IndexPair {
+	pairs := make([]IndexPair, len(s.committed), len(s.committed)+len(s.left))
+	copy(pairs, s.committed)
+	for _, p := range s.align() {
+		pairs = append(pairs, IndexPair{Left: s.leftBase + p.Left, Right: s.rightBase + p.Right})
+	}
+	return pairs
+}
+
+// Flush implements StreamLCS.Flush()
+func (s *streamLCS[E]) Flush() []IndexPair {
+	pairs := s.Snapshot()
+
+	s.left = nil
+	s.right = nil
+	s.leftBase = 0
+	s.rightBase = 0
+	s.committed = nil
+
+	return pairs
+}
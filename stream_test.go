@@ -0,0 +1,80 @@
+package lcs
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestStreamLCSValidAlignment(t *testing.T) {
+	left := []byte("ABCABBA")
+	right := []byte("CBABAC")
+
+	s := NewStreamLCS[byte](4)
+	for i := 0; i < len(left) || i < len(right); i++ {
+		if i < len(left) {
+			s.PushLeft(left[i])
+		}
+		if i < len(right) {
+			s.PushRight(right[i])
+		}
+	}
+
+	assertValidAlignment(t, left, right, s.Flush())
+}
+
+func TestStreamLCSNegativeWindowIsZero(t *testing.T) {
+	s := NewStreamLCS[int](-5)
+	s.PushLeft(1)
+	s.PushRight(1)
+	s.PushLeft(2)
+	s.PushRight(2)
+	s.Flush() // must not panic
+}
+
+func TestStreamLCSFlushResets(t *testing.T) {
+	s := NewStreamLCS[byte](4)
+	s.PushLeft('a')
+	s.PushRight('a')
+	first := s.Flush()
+	if len(first) != 1 {
+		t.Fatalf("first Flush(): got %v, want 1 pair", first)
+	}
+
+	s.PushLeft('b')
+	s.PushRight('b')
+	second := s.Flush()
+	if len(second) != 1 || second[0] != (IndexPair{Left: 0, Right: 0}) {
+		t.Fatalf("second Flush(): got %v, want a single pair at (0, 0)", second)
+	}
+}
+
+// TestStreamLCSThroughput guards against re-running a full O(window^2)
+// realignment on every single push, which made pushing to a long stream
+// effectively O(streamLength * window^2).
+func TestStreamLCSThroughput(t *testing.T) {
+	const window = 200
+	const n = 3000
+
+	r := rand.New(rand.NewSource(1))
+	alphabet := []byte("ABCDEFGH")
+
+	left := randomBytes(r, alphabet, n)
+	right := append([]byte(nil), left...)
+	for i := 0; i < n/20; i++ {
+		right[r.Intn(n)] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	s := NewStreamLCS[byte](window)
+	start := time.Now()
+	for i := 0; i < n; i++ {
+		s.PushLeft(left[i])
+		s.PushRight(right[i])
+	}
+	s.Flush()
+	elapsed := time.Since(start)
+
+	if elapsed > time.Second {
+		t.Fatalf("pushing %d elements with window %d took %v, expected amortized O(window) per push", n, window, elapsed)
+	}
+}